@@ -31,6 +31,16 @@ type Check interface {
 	Check(context.Context) error
 }
 
+// NamedCheck is an optional interface a Check may implement to report a
+// stable name used to identify it in verbose check output and for the
+// exclude query parameter. Checks registered without implementing this
+// interface are given a generated name.
+type NamedCheck interface {
+	Check
+	// Name returns the stable name used to identify this check.
+	Name() string
+}
+
 // CheckFunc is a convenience type to implement Check using a bare function.
 type CheckFunc func(context.Context) error
 
@@ -115,6 +125,9 @@ var (
 	// Configures the final path element of the URL serving the readyness check.
 	// Changes to this variable will only take effect when done before calling New.
 	ReadyPath = "/readyz"
+	// Configures the final path element of the URL serving the startup check.
+	// Changes to this variable will only take effect when done before calling New.
+	StartupPath = "/startupz"
 	// Configures the final path element of the URL serving the info endpoint.
 	// Changes to this variable will only take effect when done before calling New.
 	InfoPath = "/infoz"
@@ -141,24 +154,293 @@ func WithReadynessTimeout(t time.Duration) Option {
 	}
 }
 
-// Handler implements liveness and readyness checking.
+// WithBackgroundInterval creates an Option that makes the Handler execute its
+// startup, liveness and readyness checks periodically every d in a
+// background goroutine instead of synchronously on every request. The HTTP
+// handlers then serve the last cached result, which avoids overloading
+// dependencies (SQL pings, HTTP round-trips, ...) when probed frequently,
+// e.g. by Kubernetes. The background goroutine is started by the first call
+// to ServeHTTP and stopped by Close.
+func WithBackgroundInterval(d time.Duration) Option {
+	return func(h *Handler) {
+		h.backgroundInterval = d
+	}
+}
+
+// WithCacheTTL creates an Option that sets the maximum age a result cached
+// via WithBackgroundInterval may have before it is considered stale. A
+// request served while the cached result is older than d fails with
+// ErrCacheExpired, e.g. because the background goroutine got stuck.
+func WithCacheTTL(d time.Duration) Option {
+	return func(h *Handler) {
+		h.cacheTTL = d
+	}
+}
+
+// namedCheck pairs a Check with the name it is reported under.
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// ErrCacheExpired is reported when a request is served from a cached
+// background check result (see WithBackgroundInterval) that is older than
+// the configured WithCacheTTL.
+var ErrCacheExpired = errors.New("cached check result expired")
+
+// CheckResult reports the outcome of executing a single named Check.
+type CheckResult struct {
+	// Name identifies the check as registered with AddCheck, AddCheckFunc or
+	// AddNamedCheck.
+	Name string
+	// Err holds the error returned by the check or nil if it succeeded.
+	Err error
+	// Duration reports how long the check took to execute.
+	Duration time.Duration
+	// LastSuccess holds the time this check last succeeded, across
+	// executions. It is the zero time if the check has never succeeded.
+	LastSuccess time.Time
+}
+
+// Ok reports whether the check completed successfully.
+func (r CheckResult) Ok() bool {
+	return r.Err == nil
+}
+
+// cachedResult holds the result of a background check execution together
+// with the time it was produced.
+type cachedResult struct {
+	results   []CheckResult
+	err       error
+	timestamp time.Time
+}
+
+// checkPool manages one independent collection of named checks (startup,
+// liveness or readiness), their execution and the cached result produced by
+// background execution.
+type checkPool struct {
+	lock   sync.RWMutex
+	checks []namedCheck
+
+	successLock sync.Mutex
+	lastSuccess map[string]time.Time
+
+	cacheLock sync.RWMutex
+	cached    *cachedResult
+}
+
+func newCheckPool() *checkPool {
+	return &checkPool{lastSuccess: make(map[string]time.Time)}
+}
+
+// add registers c under name, generating a name if name is empty.
+func (p *checkPool) add(name string, c Check) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if name == "" {
+		name = fmt.Sprintf("check-%d", len(p.checks))
+	}
+
+	p.checks = append(p.checks, namedCheck{name: name, check: c})
+}
+
+// execute runs every registered check in parallel, skipping any check whose
+// name is listed in exclude, applying timeout (if any), reporting errors to
+// errorLogger and, if metrics is non-nil, reporting every check's outcome
+// and duration to it.
+func (p *checkPool) execute(ctx context.Context, timeout time.Duration, errorLogger ErrorLogger, metrics MetricsRecorder, exclude ...string) ([]CheckResult, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	excluded := make(map[string]bool, len(exclude))
+	for _, n := range exclude {
+		excluded[n] = true
+	}
+
+	var (
+		lock    sync.Mutex
+		results = make([]CheckResult, 0, len(p.checks))
+	)
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	for _, nc := range p.checks {
+		nc := nc
+		if excluded[nc.name] {
+			continue
+		}
+
+		eg.Go(func() error {
+			start := time.Now()
+			err := nc.check.Check(ctx)
+			d := time.Since(start)
+
+			if metrics != nil {
+				metrics.ObserveCheck(nc.name, err, d)
+			}
+
+			lock.Lock()
+			results = append(results, CheckResult{Name: nc.name, Err: err, Duration: d})
+			lock.Unlock()
+
+			return err
+		})
+	}
+
+	err := eg.Wait()
+	if err != nil && errorLogger != nil {
+		errorLogger(err)
+	}
+
+	p.recordLastSuccess(results)
+
+	return results, err
+}
+
+// recordLastSuccess updates the per-check last-success timestamps and fills
+// in CheckResult.LastSuccess for every result.
+func (p *checkPool) recordLastSuccess(results []CheckResult) {
+	p.successLock.Lock()
+	defer p.successLock.Unlock()
+
+	now := time.Now()
+
+	for i := range results {
+		if results[i].Ok() {
+			p.lastSuccess[results[i].Name] = now
+		}
+		results[i].LastSuccess = p.lastSuccess[results[i].Name]
+	}
+}
+
+// refreshCache executes the pool's checks and stores the outcome as the
+// cached result.
+func (p *checkPool) refreshCache(ctx context.Context, timeout time.Duration, errorLogger ErrorLogger, metrics MetricsRecorder) {
+	results, err := p.execute(ctx, timeout, errorLogger, metrics)
+
+	p.cacheLock.Lock()
+	p.cached = &cachedResult{results: results, err: err, timestamp: time.Now()}
+	p.cacheLock.Unlock()
+}
+
+// lastResult returns the cached result produced by refreshCache. ok is
+// false if refreshCache was never called.
+func (p *checkPool) lastResult() (results []CheckResult, err error, ok bool) {
+	p.cacheLock.RLock()
+	defer p.cacheLock.RUnlock()
+
+	if p.cached == nil {
+		return nil, nil, false
+	}
+
+	return p.cached.results, p.cached.err, true
+}
+
+// cachedResults returns the cached result filtered by exclude. If cacheTTL
+// is positive and the cached result is older than cacheTTL it reports
+// ErrCacheExpired instead of the cached error. ok is false if refreshCache
+// was never called.
+func (p *checkPool) cachedResults(cacheTTL time.Duration, exclude []string) (results []CheckResult, err error, ok bool) {
+	p.cacheLock.RLock()
+	cached := p.cached
+	p.cacheLock.RUnlock()
+
+	if cached == nil {
+		return nil, nil, false
+	}
+
+	if cacheTTL > 0 {
+		if age := time.Since(cached.timestamp); age > cacheTTL {
+			results, _ = filterResults(cached.results, exclude)
+			return results, fmt.Errorf("%w: last executed %s ago", ErrCacheExpired, age.Round(time.Second)), true
+		}
+	}
+
+	results, err = filterResults(cached.results, exclude)
+	return results, err, true
+}
+
+// filterResults drops every result whose name is listed in exclude and
+// recomputes the aggregate error from the remaining results.
+func filterResults(results []CheckResult, exclude []string) ([]CheckResult, error) {
+	if len(exclude) == 0 {
+		return results, firstError(results)
+	}
+
+	excluded := make(map[string]bool, len(exclude))
+	for _, n := range exclude {
+		excluded[n] = true
+	}
+
+	filtered := make([]CheckResult, 0, len(results))
+	for _, res := range results {
+		if excluded[res.Name] {
+			continue
+		}
+		filtered = append(filtered, res)
+	}
+
+	return filtered, firstError(filtered)
+}
+
+// firstError returns the first non-nil error among results, or nil if all
+// results succeeded.
+func firstError(results []CheckResult) error {
+	for _, res := range results {
+		if res.Err != nil {
+			return res.Err
+		}
+	}
+	return nil
+}
+
+// Handler implements startup, liveness and readyness checking.
 type Handler struct {
 	ErrorLogger      ErrorLogger
 	ReadynessTimeout time.Duration
 
-	checks      []Check
-	lock        sync.RWMutex
+	startup   *checkPool
+	liveness  *checkPool
+	readiness *checkPool
+
+	startupLock   sync.Mutex
+	startupPassed bool
+
 	mux         http.ServeMux
 	infoPayload []byte
+
+	backgroundInterval time.Duration
+	cacheTTL           time.Duration
+	bgOnce             sync.Once
+	bgCancel           context.CancelFunc
+	bgDone             sync.WaitGroup
+
+	jsonResponse bool
+
+	metricsRecorder MetricsRecorder
 }
 
 // New creates a new Handler ready to use. The Handler must be
 // mounted on some HTTP path (i.e. on a http.ServeMux) to receive
-// requests.
+// requests. If WithBackgroundInterval was given, the background goroutine
+// executing the checks is started lazily on the first call to ServeHTTP
+// rather than by New itself, so that callers have a chance to register
+// their checks (AddCheck, AddReadinessCheck, ...) first; call Close to stop
+// it.
 func New(opts ...Option) *Handler {
 	h := &Handler{
 		mux:              *http.NewServeMux(),
 		ReadynessTimeout: DefaultReadynessCheckTimeout,
+		startup:          newCheckPool(),
+		liveness:         newCheckPool(),
+		readiness:        newCheckPool(),
 	}
 
 	for _, opt := range opts {
@@ -169,53 +451,149 @@ func New(opts ...Option) *Handler {
 
 	h.mux.HandleFunc(LivePath, h.handleLive)
 	h.mux.HandleFunc(ReadyPath, h.handleReady)
+	h.mux.HandleFunc(StartupPath, h.handleStartup)
 
 	return h
 }
 
-// AddCheckFunc registers c as another readyness check.
-func (h *Handler) AddCheckFunc(c CheckFunc) {
-	h.AddCheck(CheckFunc(c))
-}
-
-// AddCheck registers c as another readyness check.
-func (h *Handler) AddCheck(c Check) {
-	h.lock.Lock()
-	defer h.lock.Unlock()
+// startBackground launches the goroutine periodically refreshing the cached
+// check results. It is only ever run once per Handler, guarded by bgOnce.
+func (h *Handler) startBackground() {
+	ctx, cancel := context.WithCancel(context.Background())
+	h.bgCancel = cancel
 
-	h.checks = append(h.checks, c)
+	h.bgDone.Add(1)
+	go h.runBackground(ctx)
 }
 
-// ExecuteReadyChecks executes all readyness checks in parallel. It reports the
-// first error hit or nil if all checks pass. Every check is executed with a
-// timeout configured for the handler (if any).
-func (h *Handler) ExecuteReadyChecks(ctx context.Context) error {
-	h.lock.RLock()
-	defer h.lock.RUnlock()
+func (h *Handler) runBackground(ctx context.Context) {
+	defer h.bgDone.Done()
 
-	if h.ReadynessTimeout > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, h.ReadynessTimeout)
-		defer cancel()
-	}
+	h.refreshAll(ctx)
 
-	eg, ctx := errgroup.WithContext(ctx)
+	ticker := time.NewTicker(h.backgroundInterval)
+	defer ticker.Stop()
 
-	for _, c := range h.checks {
-		c := c
-		eg.Go(func() error { return c.Check(ctx) })
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.refreshAll(ctx)
+		}
 	}
+}
 
-	if err := eg.Wait(); err != nil {
-		if h.ErrorLogger != nil {
-			h.ErrorLogger(err)
+// refreshAll refreshes the cached result of every check pool. Once the
+// startup checks have passed once, they are no longer refreshed.
+func (h *Handler) refreshAll(ctx context.Context) {
+	if !h.startupAlreadyPassed() {
+		h.startup.refreshCache(ctx, h.ReadynessTimeout, h.ErrorLogger, h.metricsRecorder)
+		if _, err, ok := h.startup.lastResult(); ok && err == nil {
+			h.markStartupPassed()
 		}
-		return err
 	}
 
+	h.liveness.refreshCache(ctx, h.ReadynessTimeout, h.ErrorLogger, h.metricsRecorder)
+	h.readiness.refreshCache(ctx, h.ReadynessTimeout, h.ErrorLogger, h.metricsRecorder)
+}
+
+// Close stops the background goroutine started via WithBackgroundInterval,
+// waiting for it to return. Close is a no-op if background execution was
+// never enabled.
+func (h *Handler) Close() error {
+	if h.bgCancel != nil {
+		h.bgCancel()
+	}
+	h.bgDone.Wait()
+
 	return nil
 }
 
+// LastResult returns the check results produced by the most recent
+// background execution of the readyness checks (see WithBackgroundInterval),
+// together with the aggregate error. ok is false if background execution is
+// disabled or hasn't completed an execution yet.
+func (h *Handler) LastResult() (results []CheckResult, err error, ok bool) {
+	return h.readiness.lastResult()
+}
+
+func (h *Handler) startupAlreadyPassed() bool {
+	h.startupLock.Lock()
+	defer h.startupLock.Unlock()
+
+	return h.startupPassed
+}
+
+func (h *Handler) markStartupPassed() {
+	h.startupLock.Lock()
+	h.startupPassed = true
+	h.startupLock.Unlock()
+}
+
+// resolveName returns the name c reports via NamedCheck, or "" if c doesn't
+// implement that interface.
+func resolveName(c Check) string {
+	if nc, ok := c.(NamedCheck); ok {
+		return nc.Name()
+	}
+	return ""
+}
+
+// AddCheckFunc registers c as another readyness check.
+func (h *Handler) AddCheckFunc(c CheckFunc) {
+	h.AddCheck(CheckFunc(c))
+}
+
+// AddCheck registers c as another readyness check. AddCheck is an alias for
+// AddReadinessCheck kept for backwards compatibility.
+func (h *Handler) AddCheck(c Check) {
+	h.AddReadinessCheck(c)
+}
+
+// AddNamedCheck registers c as another readyness check identified by name.
+// name takes precedence over a name c might report via NamedCheck.
+func (h *Handler) AddNamedCheck(name string, c Check) {
+	h.readiness.add(name, c)
+}
+
+// AddReadinessCheck registers c as another readyness check, executed when
+// ReadyPath is probed. If c implements NamedCheck its Name is used to
+// identify it in verbose output and the exclude query parameter; otherwise a
+// name is generated.
+func (h *Handler) AddReadinessCheck(c Check) {
+	h.readiness.add(resolveName(c), c)
+}
+
+// AddLivenessCheck registers c as another liveness check, executed when
+// LivePath is probed. Liveness checks should detect "is this process broken
+// and needs to be restarted" conditions, as opposed to readyness checks
+// which detect "am I ready to serve traffic". If c implements NamedCheck its
+// Name is used to identify it in verbose output and the exclude query
+// parameter; otherwise a name is generated.
+func (h *Handler) AddLivenessCheck(c Check) {
+	h.liveness.add(resolveName(c), c)
+}
+
+// AddStartupCheck registers c as another startup check, executed when
+// StartupPath is probed. Once all startup checks have passed once,
+// StartupPath permanently reports success without executing the checks
+// again, matching the Kubernetes startup probe semantics. If c implements
+// NamedCheck its Name is used to identify it in verbose output and the
+// exclude query parameter; otherwise a name is generated.
+func (h *Handler) AddStartupCheck(c Check) {
+	h.startup.add(resolveName(c), c)
+}
+
+// ExecuteReadyChecks executes all readyness checks in parallel, skipping any
+// check whose name is listed in exclude. It reports a CheckResult for every
+// executed check together with the first error hit or nil if all checks
+// passed. Every check is executed with a timeout configured for the handler
+// (if any).
+func (h *Handler) ExecuteReadyChecks(ctx context.Context, exclude ...string) ([]CheckResult, error) {
+	return h.readiness.execute(ctx, h.ReadynessTimeout, h.ErrorLogger, h.metricsRecorder, exclude...)
+}
+
 // EnableInfo enables an info endpoint that outputs version information and
 // additional details.
 func (h *Handler) EnableInfo(infoData map[string]any) {
@@ -242,23 +620,145 @@ func (h *Handler) EnableInfo(infoData map[string]any) {
 	h.mux.HandleFunc(InfoPath, h.handleInfo)
 }
 
-// ServeHTTP dispatches and executes health checks.
+// ServeHTTP dispatches and executes health checks. If WithBackgroundInterval
+// was given, the first call to ServeHTTP starts the background refresh
+// goroutine (see New).
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.backgroundInterval > 0 {
+		h.bgOnce.Do(h.startBackground)
+	}
+
 	h.mux.ServeHTTP(w, r)
 }
 
 func (h *Handler) handleLive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNoContent)
+	results, err, ok := h.checkResultsFor(h.liveness, r)
+	h.writeChecksResponse(w, r, "livez", results, err, ok)
 }
 
 func (h *Handler) handleReady(w http.ResponseWriter, r *http.Request) {
-	if err := h.ExecuteReadyChecks(r.Context()); err != nil {
+	results, err, ok := h.checkResultsFor(h.readiness, r)
+	h.writeReadyResponse(w, r, results, err, ok)
+}
+
+// handleStartup serves the startup checks. Once they have passed once,
+// StartupPath permanently reports success, matching the Kubernetes
+// semantics of a startup probe disabling liveness/readiness checking until
+// the application is up.
+func (h *Handler) handleStartup(w http.ResponseWriter, r *http.Request) {
+	if h.startupAlreadyPassed() {
+		h.writeChecksResponse(w, r, "startupz", nil, nil, true)
+		return
+	}
+
+	results, err, ok := h.checkResultsFor(h.startup, r)
+	if ok && err == nil {
+		h.markStartupPassed()
+	}
+
+	h.writeChecksResponse(w, r, "startupz", results, err, ok)
+}
+
+// checkResultsFor returns the results to serve for r from pool. When
+// background execution is disabled the checks are executed synchronously.
+// Otherwise the last cached result is served, and ok is false if no
+// background execution has completed yet.
+func (h *Handler) checkResultsFor(pool *checkPool, r *http.Request) (results []CheckResult, err error, ok bool) {
+	exclude := r.URL.Query()["exclude"]
+
+	if h.backgroundInterval == 0 {
+		results, err = pool.execute(r.Context(), h.ReadynessTimeout, h.ErrorLogger, h.metricsRecorder, exclude...)
+		return results, err, true
+	}
+
+	return pool.cachedResults(h.cacheTTL, exclude)
+}
+
+// writeReadyResponse renders the readyness result, additionally supporting
+// the application/health+json response format (see WithJSONResponse and
+// json.go) on top of what writeChecksResponse supports.
+func (h *Handler) writeReadyResponse(w http.ResponseWriter, r *http.Request, results []CheckResult, err error, ok bool) {
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	if h.wantsJSON(r) {
+		if errors.Is(err, ErrCacheExpired) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeJSONResult(w, results)
+		return
+	}
+
+	h.writeChecksResponse(w, r, "readyz", results, err, ok)
+}
+
+// writeChecksResponse renders either a plain status code or, if the verbose
+// query parameter is set, a per-check breakdown mirroring the
+// etcd/Kubernetes health check convention. ok being false (no cached result
+// available yet) always yields a 503 response.
+func (h *Handler) writeChecksResponse(w http.ResponseWriter, r *http.Request, label string, results []CheckResult, err error, ok bool) {
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	if isVerbose(r) {
+		writeVerboseResult(w, label, results, err)
+		return
+	}
+
+	if err != nil {
 		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// isVerbose reports whether r requested verbose check output via
+// ?verbose=1 or ?verbose=true.
+func isVerbose(r *http.Request) bool {
+	switch r.URL.Query().Get("verbose") {
+	case "1", "true":
+		return true
+	default:
+		return false
+	}
+}
+
+// writeVerboseResult renders results as text/plain, one line per check
+// formatted as "[+] <name> ok" or "[-] <name> failed: <error>", followed by
+// a "<label> check passed"/"<label> check failed" summary line. A failed line
+// ends with " (last success <RFC3339 time>)" if the check succeeded before.
+func writeVerboseResult(w http.ResponseWriter, label string, results []CheckResult, err error) {
+	w.Header().Set("Content-Type", "text/plain; charset=UTF-8")
+
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	for _, res := range results {
+		switch {
+		case res.Ok():
+			fmt.Fprintf(w, "[+] %s ok\n", res.Name)
+		case !res.LastSuccess.IsZero():
+			fmt.Fprintf(w, "[-] %s failed: %s (last success %s)\n", res.Name, res.Err, res.LastSuccess.Format(time.RFC3339))
+		default:
+			fmt.Fprintf(w, "[-] %s failed: %s\n", res.Name, res.Err)
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintf(w, "%s check failed\n", label)
+	} else {
+		fmt.Fprintf(w, "%s check passed\n", label)
+	}
+}
+
 func (h *Handler) handleInfo(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.Header().Set("Content-Length", strconv.Itoa(len(h.infoPayload)))
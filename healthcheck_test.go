@@ -6,6 +6,8 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -40,6 +42,15 @@ func (h *handlerFixture) ReadynessRequest() int {
 	return w.Result().StatusCode
 }
 
+func (h *handlerFixture) StartupRequest() int {
+	r := httptest.NewRequest(http.MethodGet, StartupPath, nil)
+	w := httptest.NewRecorder()
+
+	h.handler.ServeHTTP(w, r)
+
+	return w.Result().StatusCode
+}
+
 func (h *handlerFixture) InfoRequest() ([]byte, int) {
 	r := httptest.NewRequest(http.MethodGet, InfoPath, nil)
 	w := httptest.NewRecorder()
@@ -52,18 +63,18 @@ func (h *handlerFixture) InfoRequest() ([]byte, int) {
 func TestHandler_ExecuteReadyChecks(t *testing.T) {
 	With(t, new(handlerFixture)).
 		Run("noCheck", func(t *testing.T, f *handlerFixture) {
-			err := f.handler.ExecuteReadyChecks(context.Background())
+			_, err := f.handler.ExecuteReadyChecks(context.Background())
 			ExpectThat(t, err).Is(NoError())
 		}).
 		Run("singleSuccessfulCheck", func(t *testing.T, f *handlerFixture) {
 			f.handler.AddCheckFunc(func(context.Context) error { return nil })
-			err := f.handler.ExecuteReadyChecks(context.Background())
+			_, err := f.handler.ExecuteReadyChecks(context.Background())
 			ExpectThat(t, err).Is(NoError())
 		}).
 		Run("failingCheck", func(t *testing.T, f *handlerFixture) {
 			want := errors.New("failed")
 			f.handler.AddCheckFunc(func(context.Context) error { return want })
-			err := f.handler.ExecuteReadyChecks(context.Background())
+			_, err := f.handler.ExecuteReadyChecks(context.Background())
 			ExpectThat(t, err).Is(Error(want))
 		})
 }
@@ -82,7 +93,7 @@ func TestHandler_ExecuteReadyChecks_withTimeout(t *testing.T) {
 		}
 	})
 
-	err := h.ExecuteReadyChecks(context.Background())
+	_, err := h.ExecuteReadyChecks(context.Background())
 	ExpectThat(t, err).Is(Error(context.DeadlineExceeded))
 }
 
@@ -136,6 +147,265 @@ func TestHandler(t *testing.T) {
 		})
 }
 
+func TestHandler_checkPools(t *testing.T) {
+	t.Run("livenessAndReadinessAreIndependent", func(t *testing.T) {
+		h := New()
+		h.AddReadinessCheck(CheckFunc(func(context.Context) error { return errors.New("db down") }))
+
+		readyReq := httptest.NewRequest(http.MethodGet, ReadyPath, nil)
+		readyW := httptest.NewRecorder()
+		h.ServeHTTP(readyW, readyReq)
+		ExpectThat(t, readyW.Result().StatusCode).Is(Equal(http.StatusServiceUnavailable))
+
+		liveReq := httptest.NewRequest(http.MethodGet, LivePath, nil)
+		liveW := httptest.NewRecorder()
+		h.ServeHTTP(liveW, liveReq)
+		ExpectThat(t, liveW.Result().StatusCode).Is(Equal(http.StatusNoContent))
+	})
+
+	t.Run("livenessCheckFails", func(t *testing.T) {
+		h := New()
+		h.AddLivenessCheck(CheckFunc(func(context.Context) error { return errors.New("deadlocked") }))
+
+		r := httptest.NewRequest(http.MethodGet, LivePath, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		ExpectThat(t, w.Result().StatusCode).Is(Equal(http.StatusServiceUnavailable))
+	})
+
+	t.Run("startupChecksRegisteredAfterNewAreStillHonoured", func(t *testing.T) {
+		h := New(WithBackgroundInterval(time.Hour))
+		defer h.Close()
+
+		h.AddStartupCheck(CheckFunc(func(context.Context) error { return errors.New("not ready") }))
+
+		r := httptest.NewRequest(http.MethodGet, StartupPath, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		waitForCondition(t, func() bool {
+			_, _, ok := h.startup.lastResult()
+			return ok
+		})
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		ExpectThat(t, w.Result().StatusCode).Is(Equal(http.StatusServiceUnavailable))
+		ExpectThat(t, h.startupAlreadyPassed()).Is(Equal(false))
+	})
+
+	t.Run("startupPendingUntilPassed", func(t *testing.T) {
+		var ready int32
+		h := New()
+		h.AddStartupCheck(CheckFunc(func(context.Context) error {
+			if atomic.LoadInt32(&ready) == 0 {
+				return errors.New("not yet")
+			}
+			return nil
+		}))
+
+		statusOf := func() int {
+			r := httptest.NewRequest(http.MethodGet, StartupPath, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			return w.Result().StatusCode
+		}
+
+		ExpectThat(t, statusOf()).Is(Equal(http.StatusServiceUnavailable))
+
+		atomic.StoreInt32(&ready, 1)
+		ExpectThat(t, statusOf()).Is(Equal(http.StatusNoContent))
+
+		atomic.StoreInt32(&ready, 0)
+		ExpectThat(t, statusOf()).Is(Equal(http.StatusNoContent))
+	})
+}
+
+func TestHandler_verbose(t *testing.T) {
+	verboseRequest := func(h *Handler, exclude ...string) ([]byte, int, string) {
+		u := ReadyPath + "?verbose=1"
+		for _, e := range exclude {
+			u += "&exclude=" + e
+		}
+
+		r := httptest.NewRequest(http.MethodGet, u, nil)
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		res := w.Result()
+		return w.Body.Bytes(), res.StatusCode, res.Header.Get("Content-Type")
+	}
+
+	t.Run("allChecksPass", func(t *testing.T) {
+		h := New()
+		h.AddNamedCheck("db", CheckFunc(func(context.Context) error { return nil }))
+
+		body, status, contentType := verboseRequest(h)
+
+		ExpectThat(t, status).Is(Equal(http.StatusOK))
+		ExpectThat(t, contentType).Is(StringContaining("text/plain"))
+		ExpectThat(t, string(body)).Is(StringContaining("[+] db ok"))
+		ExpectThat(t, string(body)).Is(StringContaining("readyz check passed"))
+	})
+
+	t.Run("failingCheck", func(t *testing.T) {
+		h := New()
+		h.AddNamedCheck("upstream", CheckFunc(func(context.Context) error {
+			return errors.New("boom")
+		}))
+
+		body, status, _ := verboseRequest(h)
+
+		ExpectThat(t, status).Is(Equal(http.StatusServiceUnavailable))
+		ExpectThat(t, string(body)).Is(StringContaining("[-] upstream failed: boom"))
+		ExpectThat(t, string(body)).Is(StringContaining("readyz check failed"))
+	})
+
+	t.Run("excludeFilter", func(t *testing.T) {
+		h := New()
+		h.AddNamedCheck("slow", CheckFunc(func(context.Context) error {
+			return errors.New("degraded")
+		}))
+
+		body, status, _ := verboseRequest(h, "slow")
+
+		ExpectThat(t, status).Is(Equal(http.StatusOK))
+		ExpectThat(t, string(body)).Is(Equal("readyz check passed\n"))
+	})
+
+	t.Run("excludeUnknownCheckHasNoEffect", func(t *testing.T) {
+		h := New()
+		h.AddNamedCheck("db", CheckFunc(func(context.Context) error { return nil }))
+
+		body, status, _ := verboseRequest(h, "does-not-exist")
+
+		ExpectThat(t, status).Is(Equal(http.StatusOK))
+		ExpectThat(t, string(body)).Is(StringContaining("[+] db ok"))
+	})
+}
+
+func TestHandler_background(t *testing.T) {
+	t.Run("noResultYet", func(t *testing.T) {
+		h := New(WithBackgroundInterval(time.Hour))
+		defer h.Close()
+
+		r := httptest.NewRequest(http.MethodGet, ReadyPath, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		ExpectThat(t, w.Result().StatusCode).Is(Equal(http.StatusServiceUnavailable))
+	})
+
+	t.Run("servesCachedResult", func(t *testing.T) {
+		var calls int32
+		h := New(WithBackgroundInterval(time.Millisecond))
+		defer h.Close()
+
+		h.AddCheckFunc(func(context.Context) error {
+			atomic.AddInt32(&calls, 1)
+			return nil
+		})
+
+		// The first request starts the background goroutine (it is no
+		// longer started by New, to give registration a chance to happen
+		// first); wait for it to have produced a cached result.
+		r := httptest.NewRequest(http.MethodGet, ReadyPath, nil)
+		h.ServeHTTP(httptest.NewRecorder(), r)
+
+		waitForCondition(t, func() bool {
+			_, _, ok := h.LastResult()
+			return ok
+		})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		ExpectThat(t, w.Result().StatusCode).Is(Equal(http.StatusNoContent))
+		ExpectThat(t, atomic.LoadInt32(&calls) >= 1).Is(Equal(true))
+	})
+
+	t.Run("cacheExpiry", func(t *testing.T) {
+		h := New(WithBackgroundInterval(time.Hour), WithCacheTTL(time.Millisecond))
+		defer h.Close()
+
+		h.AddCheckFunc(func(context.Context) error { return nil })
+
+		r := httptest.NewRequest(http.MethodGet, ReadyPath, nil)
+		h.ServeHTTP(httptest.NewRecorder(), r)
+
+		waitForCondition(t, func() bool {
+			_, _, ok := h.LastResult()
+			return ok
+		})
+
+		time.Sleep(5 * time.Millisecond)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		ExpectThat(t, w.Result().StatusCode).Is(Equal(http.StatusServiceUnavailable))
+	})
+
+	t.Run("checksRegisteredAfterNewAreStillHonoured", func(t *testing.T) {
+		h := New(WithBackgroundInterval(time.Hour))
+		defer h.Close()
+
+		h.AddReadinessCheck(CheckFunc(func(context.Context) error { return errors.New("db down") }))
+
+		r := httptest.NewRequest(http.MethodGet, ReadyPath, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		waitForCondition(t, func() bool {
+			_, _, ok := h.LastResult()
+			return ok
+		})
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		ExpectThat(t, w.Result().StatusCode).Is(Equal(http.StatusServiceUnavailable))
+	})
+
+	t.Run("concurrentAccess", func(t *testing.T) {
+		h := New(WithBackgroundInterval(time.Millisecond))
+		defer h.Close()
+
+		h.AddCheckFunc(func(context.Context) error { return nil })
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r := httptest.NewRequest(http.MethodGet, ReadyPath, nil)
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, r)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+// waitForCondition polls cond until it returns true or fails t after a short
+// timeout.
+func waitForCondition(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatal("condition not met within timeout")
+}
+
 func TestCheckHTTPResponse(t *testing.T) {
 	f := new(HTTPServerFixture)
 
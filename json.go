@@ -0,0 +1,110 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// WithJSONResponse creates an Option that makes ReadyPath always respond
+// using the application/health+json format (see writeJSONResult),
+// regardless of the request's Accept header.
+func WithJSONResponse(enabled bool) Option {
+	return func(h *Handler) {
+		h.jsonResponse = enabled
+	}
+}
+
+// wantsJSON reports whether r should be served an application/health+json
+// response: either because WithJSONResponse(true) was configured, or
+// because r negotiated it via its Accept header.
+func (h *Handler) wantsJSON(r *http.Request) bool {
+	if h.jsonResponse {
+		return true
+	}
+	return strings.Contains(r.Header.Get("Accept"), "json")
+}
+
+// healthJSON is the top-level body of an application/health+json response,
+// following the shape of the IETF "application/health+json" draft.
+type healthJSON struct {
+	Status string            `json:"status"`
+	Checks []healthCheckJSON `json:"checks"`
+}
+
+// healthCheckJSON is a single check's entry in a healthJSON response.
+type healthCheckJSON struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// checkStatusJSON maps err to the status string used in a healthCheckJSON
+// entry, distinguishing a cancelled check (the enclosing context was
+// cancelled or its deadline exceeded) from a genuine failure.
+func checkStatusJSON(err error) string {
+	switch {
+	case err == nil:
+		return "pass"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "cancelled"
+	default:
+		return "fail"
+	}
+}
+
+// aggregateStatusJSON derives the overall status from the per-check
+// statuses: "fail" if any check failed, else "cancelled" if any check was
+// cancelled, else "pass".
+func aggregateStatusJSON(results []CheckResult) string {
+	status := "pass"
+
+	for _, res := range results {
+		switch checkStatusJSON(res.Err) {
+		case "fail":
+			return "fail"
+		case "cancelled":
+			status = "cancelled"
+		}
+	}
+
+	return status
+}
+
+// writeJSONResult renders results as an application/health+json response
+// body.
+func writeJSONResult(w http.ResponseWriter, results []CheckResult) {
+	body := healthJSON{
+		Status: aggregateStatusJSON(results),
+		Checks: make([]healthCheckJSON, 0, len(results)),
+	}
+
+	for _, res := range results {
+		entry := healthCheckJSON{
+			Name:       res.Name,
+			Status:     checkStatusJSON(res.Err),
+			DurationMs: res.Duration.Milliseconds(),
+		}
+		if res.Err != nil {
+			entry.Error = res.Err.Error()
+		}
+		body.Checks = append(body.Checks, entry)
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/health+json")
+	if body.Status == "pass" {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(data)
+}
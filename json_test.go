@@ -0,0 +1,106 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/halimath/expect-go"
+)
+
+func jsonRequest(h *Handler, accept string) (map[string]any, int, string) {
+	r := httptest.NewRequest(http.MethodGet, ReadyPath, nil)
+	if accept != "" {
+		r.Header.Set("Accept", accept)
+	}
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	res := w.Result()
+
+	var body map[string]any
+	if w.Body.Len() > 0 {
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			panic(err)
+		}
+	}
+
+	return body, res.StatusCode, res.Header.Get("Content-Type")
+}
+
+func TestHandler_jsonResponse(t *testing.T) {
+	t.Run("negotiatedViaAcceptHeader_pass", func(t *testing.T) {
+		h := New()
+		h.AddReadinessCheck(CheckFunc(func(context.Context) error { return nil }))
+
+		body, status, contentType := jsonRequest(h, "application/json")
+
+		ExpectThat(t, status).Is(Equal(http.StatusOK))
+		ExpectThat(t, contentType).Is(Equal("application/health+json"))
+		ExpectThat(t, body["status"].(string)).Is(Equal("pass"))
+	})
+
+	t.Run("negotiatedViaAcceptHeader_fail", func(t *testing.T) {
+		h := New()
+		h.AddNamedCheck("upstream", CheckFunc(func(context.Context) error {
+			return errors.New("boom")
+		}))
+
+		body, status, _ := jsonRequest(h, "application/json")
+
+		ExpectThat(t, status).Is(Equal(http.StatusServiceUnavailable))
+		ExpectThat(t, body["status"].(string)).Is(Equal("fail"))
+
+		checks := body["checks"].([]any)
+		ExpectThat(t, len(checks)).Is(Equal(1))
+
+		entry := checks[0].(map[string]any)
+		ExpectThat(t, entry["name"].(string)).Is(Equal("upstream"))
+		ExpectThat(t, entry["status"].(string)).Is(Equal("fail"))
+		ExpectThat(t, entry["error"].(string)).Is(Equal("boom"))
+	})
+
+	t.Run("cancelledStatusForContextDeadline", func(t *testing.T) {
+		h := New(WithReadynessTimeout(time.Nanosecond))
+		h.AddNamedCheck("slow", CheckFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}))
+
+		body, status, _ := jsonRequest(h, "application/json")
+
+		ExpectThat(t, status).Is(Equal(http.StatusServiceUnavailable))
+		ExpectThat(t, body["status"].(string)).Is(Equal("cancelled"))
+
+		checks := body["checks"].([]any)
+		entry := checks[0].(map[string]any)
+		ExpectThat(t, entry["status"].(string)).Is(Equal("cancelled"))
+	})
+
+	t.Run("withJSONResponseOptionForcesJSON", func(t *testing.T) {
+		h := New(WithJSONResponse(true))
+		h.AddReadinessCheck(CheckFunc(func(context.Context) error { return nil }))
+
+		body, status, contentType := jsonRequest(h, "")
+
+		ExpectThat(t, status).Is(Equal(http.StatusOK))
+		ExpectThat(t, contentType).Is(Equal("application/health+json"))
+		ExpectThat(t, body["status"].(string)).Is(Equal("pass"))
+	})
+
+	t.Run("noJSONWithoutNegotiation", func(t *testing.T) {
+		h := New()
+		h.AddReadinessCheck(CheckFunc(func(context.Context) error { return nil }))
+
+		body, status, contentType := jsonRequest(h, "")
+
+		ExpectThat(t, status).Is(Equal(http.StatusNoContent))
+		ExpectThat(t, contentType).Is(Equal(""))
+		ExpectThat(t, len(body)).Is(Equal(0))
+	})
+}
@@ -0,0 +1,24 @@
+package healthcheck
+
+import "time"
+
+// MetricsRecorder defines the interface used to report check outcomes and
+// latencies to an external metrics system, such as Prometheus (see the
+// healthcheck/prom subpackage). Implementations must be safe for concurrent
+// use, as ObserveCheck may be called concurrently for checks belonging to
+// the same pool.
+type MetricsRecorder interface {
+	// ObserveCheck is called once a check identified by name has completed,
+	// reporting the error it returned (nil on success) and how long it took.
+	ObserveCheck(name string, err error, d time.Duration)
+}
+
+// WithMetricsRecorder creates an Option that makes the Handler report every
+// check's outcome and duration to r as it executes checks, be it
+// synchronously per request or via the background goroutine started by
+// WithBackgroundInterval.
+func WithMetricsRecorder(r MetricsRecorder) Option {
+	return func(h *Handler) {
+		h.metricsRecorder = r
+	}
+}
@@ -0,0 +1,54 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/halimath/expect-go"
+)
+
+type recordedObservation struct {
+	name string
+	err  error
+	d    time.Duration
+}
+
+type testMetricsRecorder struct {
+	lock         sync.Mutex
+	observations []recordedObservation
+}
+
+func (r *testMetricsRecorder) ObserveCheck(name string, err error, d time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.observations = append(r.observations, recordedObservation{name: name, err: err, d: d})
+}
+
+func (r *testMetricsRecorder) all() []recordedObservation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]recordedObservation(nil), r.observations...)
+}
+
+func TestHandler_metricsRecorder(t *testing.T) {
+	rec := &testMetricsRecorder{}
+	h := New(WithMetricsRecorder(rec))
+	h.AddNamedCheck("ok", CheckFunc(func(context.Context) error { return nil }))
+	h.AddNamedCheck("fails", CheckFunc(func(context.Context) error { return errors.New("boom") }))
+
+	_, _ = h.ExecuteReadyChecks(context.Background())
+
+	observations := rec.all()
+	ExpectThat(t, len(observations)).Is(Equal(2))
+
+	seen := make(map[string]error, len(observations))
+	for _, o := range observations {
+		seen[o.name] = o.err
+	}
+
+	ExpectThat(t, seen["ok"]).Is(NoError())
+	ExpectThat(t, seen["fails"].Error()).Is(Equal("boom"))
+}
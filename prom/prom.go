@@ -0,0 +1,60 @@
+// Package prom provides a healthcheck.MetricsRecorder implementation that
+// reports check outcomes and latencies as Prometheus metrics.
+package prom
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Recorder implements healthcheck.MetricsRecorder by reporting check
+// outcomes and latencies as Prometheus metrics:
+//
+//   - healthcheck_check_total{name,result} (counter) counts check
+//     executions, result being either "pass" or "fail".
+//   - healthcheck_check_duration_seconds{name} (histogram) observes how long
+//     a check took to execute.
+//   - healthcheck_up{name} (gauge) reports 1 if the check's last execution
+//     succeeded, 0 otherwise.
+type Recorder struct {
+	total    *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	up       *prometheus.GaugeVec
+}
+
+// NewRecorder creates a Recorder and registers its metrics with reg.
+func NewRecorder(reg prometheus.Registerer) *Recorder {
+	r := &Recorder{
+		total: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "healthcheck_check_total",
+			Help: "Total number of health check executions, partitioned by check name and result.",
+		}, []string{"name", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "healthcheck_check_duration_seconds",
+			Help: "Duration of health check executions in seconds, partitioned by check name.",
+		}, []string{"name"}),
+		up: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "healthcheck_up",
+			Help: "Whether a health check's last execution succeeded (1) or failed (0).",
+		}, []string{"name"}),
+	}
+
+	reg.MustRegister(r.total, r.duration, r.up)
+
+	return r
+}
+
+// ObserveCheck implements healthcheck.MetricsRecorder.
+func (r *Recorder) ObserveCheck(name string, err error, d time.Duration) {
+	result := "pass"
+	up := 1.0
+	if err != nil {
+		result = "fail"
+		up = 0.0
+	}
+
+	r.total.WithLabelValues(name, result).Inc()
+	r.duration.WithLabelValues(name).Observe(d.Seconds())
+	r.up.WithLabelValues(name).Set(up)
+}
@@ -0,0 +1,33 @@
+package prom
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	. "github.com/halimath/expect-go"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Run("observesSuccess", func(t *testing.T) {
+		reg := prometheus.NewRegistry()
+		r := NewRecorder(reg)
+
+		r.ObserveCheck("db", nil, 0)
+
+		ExpectThat(t, testutil.ToFloat64(r.total.WithLabelValues("db", "pass"))).Is(Equal(1.0))
+		ExpectThat(t, testutil.ToFloat64(r.up.WithLabelValues("db"))).Is(Equal(1.0))
+	})
+
+	t.Run("observesFailure", func(t *testing.T) {
+		reg := prometheus.NewRegistry()
+		r := NewRecorder(reg)
+
+		r.ObserveCheck("db", errors.New("boom"), 0)
+
+		ExpectThat(t, testutil.ToFloat64(r.total.WithLabelValues("db", "fail"))).Is(Equal(1.0))
+		ExpectThat(t, testutil.ToFloat64(r.up.WithLabelValues("db"))).Is(Equal(0.0))
+	})
+}
@@ -0,0 +1,188 @@
+package healthcheck
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+var (
+	// DefaultRetryMaxAttempts is the default number of attempts CheckRetry
+	// performs before reporting a failure.
+	DefaultRetryMaxAttempts = 3
+	// DefaultRetryInitialInterval is the default backoff CheckRetry applies
+	// after the first failed attempt.
+	DefaultRetryInitialInterval = 500 * time.Millisecond
+	// DefaultRetryMultiplier is the default factor CheckRetry's backoff
+	// grows by after every failed attempt.
+	DefaultRetryMultiplier = 1.5
+	// DefaultRetryRandomizationFactor is the default jitter CheckRetry
+	// applies to its backoff.
+	DefaultRetryRandomizationFactor = 0.5
+)
+
+// RetryOption defines a function type used to customize the retry behavior
+// created by CheckRetry.
+type RetryOption func(*retryConfig)
+
+// WithMaxAttempts creates a RetryOption that sets the maximum number of
+// times the wrapped Check is attempted before CheckRetry reports a failure.
+// n < 1 is treated as 1: CheckRetry always performs at least one attempt.
+func WithMaxAttempts(n int) RetryOption {
+	return func(c *retryConfig) {
+		c.maxAttempts = n
+	}
+}
+
+// WithInitialInterval creates a RetryOption that sets the backoff applied
+// after the first failed attempt.
+func WithInitialInterval(d time.Duration) RetryOption {
+	return func(c *retryConfig) {
+		c.initialInterval = d
+	}
+}
+
+// WithMaxInterval creates a RetryOption that caps the backoff computed for
+// any attempt at d. A non-positive d (the default) leaves the backoff
+// uncapped other than by the enclosing context's deadline.
+func WithMaxInterval(d time.Duration) RetryOption {
+	return func(c *retryConfig) {
+		c.maxInterval = d
+	}
+}
+
+// WithMultiplier creates a RetryOption that sets the factor the backoff
+// grows by after every failed attempt.
+func WithMultiplier(m float64) RetryOption {
+	return func(c *retryConfig) {
+		c.multiplier = m
+	}
+}
+
+// WithRandomizationFactor creates a RetryOption that sets the jitter applied
+// to the computed backoff: the actual backoff is picked uniformly from
+// [backoff*(1-f), backoff*(1+f)].
+func WithRandomizationFactor(f float64) RetryOption {
+	return func(c *retryConfig) {
+		c.randomizationFactor = f
+	}
+}
+
+// WithRetryableError creates a RetryOption that sets fn as the predicate
+// deciding whether an error returned by the wrapped Check should be
+// retried. By default every error is considered retryable; use this to
+// opt out for errors that are unlikely to be transient, such as context
+// deadlines.
+func WithRetryableError(fn func(error) bool) RetryOption {
+	return func(c *retryConfig) {
+		c.retryable = fn
+	}
+}
+
+type retryConfig struct {
+	maxAttempts         int
+	initialInterval     time.Duration
+	maxInterval         time.Duration
+	multiplier          float64
+	randomizationFactor float64
+	retryable           func(error) bool
+}
+
+// backoff computes the (jittered) delay to apply before the retry following
+// the given zero-based attempt number.
+func (c retryConfig) backoff(attempt int) time.Duration {
+	d := float64(c.initialInterval) * math.Pow(c.multiplier, float64(attempt))
+	if c.maxInterval > 0 && d > float64(c.maxInterval) {
+		d = float64(c.maxInterval)
+	}
+
+	if c.randomizationFactor > 0 {
+		delta := c.randomizationFactor * d
+		d += delta * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(d)
+}
+
+// sleep waits for the backoff following attempt, capped at ctx's remaining
+// deadline, returning early with ctx.Err() if ctx is done first.
+func (c retryConfig) sleep(ctx context.Context, attempt int) error {
+	d := c.backoff(attempt)
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < d {
+			d = remaining
+		}
+	}
+
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// CheckRetry wraps c, retrying transient failures using exponential backoff
+// with jitter before reporting them as a failure. This is useful because
+// checks against network dependencies (SQL pings, HTTP round-trips) blip
+// occasionally, and a single such failure shouldn't fail a health probe.
+// By default up to DefaultRetryMaxAttempts attempts are made, starting with
+// a DefaultRetryInitialInterval backoff growing by DefaultRetryMultiplier
+// and jittered by DefaultRetryRandomizationFactor; use the With* RetryOption
+// functions to customize this. The backoff always respects ctx, returning
+// immediately with ctx.Err() if ctx is done while waiting to retry.
+// CheckRetry always performs at least one attempt, regardless of
+// WithMaxAttempts.
+func CheckRetry(c Check, opts ...RetryOption) Check {
+	cfg := retryConfig{
+		maxAttempts:         DefaultRetryMaxAttempts,
+		initialInterval:     DefaultRetryInitialInterval,
+		multiplier:          DefaultRetryMultiplier,
+		randomizationFactor: DefaultRetryRandomizationFactor,
+		retryable:           func(error) bool { return true },
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	if cfg.maxAttempts < 1 {
+		cfg.maxAttempts = 1
+	}
+
+	return CheckFunc(func(ctx context.Context) error {
+		var err error
+
+		for attempt := 0; attempt < cfg.maxAttempts; attempt++ {
+			err = c.Check(ctx)
+			if err == nil {
+				return nil
+			}
+
+			if !cfg.retryable(err) {
+				return err
+			}
+
+			if attempt == cfg.maxAttempts-1 {
+				return err
+			}
+
+			if sleepErr := cfg.sleep(ctx, attempt); sleepErr != nil {
+				return sleepErr
+			}
+		}
+
+		return err
+	})
+}
@@ -0,0 +1,172 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/halimath/expect-go"
+)
+
+func TestCheckRetry(t *testing.T) {
+	t.Run("succeedsWithoutRetry", func(t *testing.T) {
+		var attempts int32
+		c := CheckRetry(CheckFunc(func(context.Context) error {
+			atomic.AddInt32(&attempts, 1)
+			return nil
+		}))
+
+		err := c.Check(context.Background())
+
+		ExpectThat(t, err).Is(NoError())
+		ExpectThat(t, int(atomic.LoadInt32(&attempts))).Is(Equal(1))
+	})
+
+	t.Run("retriesUntilSuccess", func(t *testing.T) {
+		var attempts int32
+		c := CheckRetry(
+			CheckFunc(func(context.Context) error {
+				if atomic.AddInt32(&attempts, 1) < 3 {
+					return errors.New("transient")
+				}
+				return nil
+			}),
+			WithMaxAttempts(5),
+			WithInitialInterval(time.Millisecond),
+			WithRandomizationFactor(0),
+		)
+
+		err := c.Check(context.Background())
+
+		ExpectThat(t, err).Is(NoError())
+		ExpectThat(t, int(atomic.LoadInt32(&attempts))).Is(Equal(3))
+	})
+
+	t.Run("givesUpAfterMaxAttempts", func(t *testing.T) {
+		var attempts int32
+		want := errors.New("always failing")
+		c := CheckRetry(
+			CheckFunc(func(context.Context) error {
+				atomic.AddInt32(&attempts, 1)
+				return want
+			}),
+			WithMaxAttempts(3),
+			WithInitialInterval(time.Millisecond),
+			WithRandomizationFactor(0),
+		)
+
+		err := c.Check(context.Background())
+
+		ExpectThat(t, err).Is(Error(want))
+		ExpectThat(t, int(atomic.LoadInt32(&attempts))).Is(Equal(3))
+	})
+
+	t.Run("nonRetryableErrorStopsImmediately", func(t *testing.T) {
+		var attempts int32
+		want := errors.New("permanent")
+		c := CheckRetry(
+			CheckFunc(func(context.Context) error {
+				atomic.AddInt32(&attempts, 1)
+				return want
+			}),
+			WithMaxAttempts(5),
+			WithInitialInterval(time.Millisecond),
+			WithRetryableError(func(error) bool { return false }),
+		)
+
+		err := c.Check(context.Background())
+
+		ExpectThat(t, err).Is(Error(want))
+		ExpectThat(t, int(atomic.LoadInt32(&attempts))).Is(Equal(1))
+	})
+
+	t.Run("zeroMaxAttemptsStillRunsOnce", func(t *testing.T) {
+		var attempts int32
+		want := errors.New("always failing")
+		c := CheckRetry(
+			CheckFunc(func(context.Context) error {
+				atomic.AddInt32(&attempts, 1)
+				return want
+			}),
+			WithMaxAttempts(0),
+		)
+
+		err := c.Check(context.Background())
+
+		ExpectThat(t, err).Is(Error(want))
+		ExpectThat(t, int(atomic.LoadInt32(&attempts))).Is(Equal(1))
+	})
+
+	t.Run("cancellationStopsRetryLoop", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		c := CheckRetry(
+			CheckFunc(func(context.Context) error {
+				return errors.New("transient")
+			}),
+			WithMaxAttempts(10),
+			WithInitialInterval(time.Hour),
+		)
+
+		done := make(chan error, 1)
+		go func() {
+			done <- c.Check(ctx)
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+
+		select {
+		case err := <-done:
+			ExpectThat(t, err).Is(Error(context.Canceled))
+		case <-time.After(time.Second):
+			t.Fatal("CheckRetry did not return promptly after context cancellation")
+		}
+	})
+
+	t.Run("stopsImmediatelyOnceContextDeadlineHasPassed", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Millisecond)
+		defer cancel()
+
+		var attempts int32
+		c := CheckRetry(
+			CheckFunc(func(ctx context.Context) error {
+				atomic.AddInt32(&attempts, 1)
+				<-ctx.Done()
+				return ctx.Err()
+			}),
+			WithMaxAttempts(5),
+			WithInitialInterval(time.Hour),
+		)
+
+		err := c.Check(ctx)
+
+		ExpectThat(t, err).Is(Error(context.DeadlineExceeded))
+		ExpectThat(t, int(atomic.LoadInt32(&attempts))).Is(Equal(1))
+	})
+
+	t.Run("backoffGrowsExponentially", func(t *testing.T) {
+		cfg := retryConfig{
+			initialInterval:     10 * time.Millisecond,
+			multiplier:          2,
+			randomizationFactor: 0,
+		}
+
+		ExpectThat(t, cfg.backoff(0)).Is(Equal(10 * time.Millisecond))
+		ExpectThat(t, cfg.backoff(1)).Is(Equal(20 * time.Millisecond))
+		ExpectThat(t, cfg.backoff(2)).Is(Equal(40 * time.Millisecond))
+	})
+
+	t.Run("backoffRespectsMaxInterval", func(t *testing.T) {
+		cfg := retryConfig{
+			initialInterval:     10 * time.Millisecond,
+			multiplier:          10,
+			maxInterval:         15 * time.Millisecond,
+			randomizationFactor: 0,
+		}
+
+		ExpectThat(t, cfg.backoff(3)).Is(Equal(15 * time.Millisecond))
+	})
+}